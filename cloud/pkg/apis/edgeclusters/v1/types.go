@@ -0,0 +1,87 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Mission describes a unit of work that the cloud wants one or more edge
+// clusters to run.
+type Mission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MissionSpec   `json:"spec,omitempty"`
+	Status MissionStatus `json:"status,omitempty"`
+}
+
+// MissionSpec is the desired state of a Mission.
+type MissionSpec struct {
+	// Placement selects which edge clusters this mission is delivered to.
+	// An empty Placement matches no clusters; set ClusterNames and/or
+	// ClusterSelector to target one or more edge clusters.
+	Placement MissionPlacement `json:"placement,omitempty"`
+}
+
+// MissionPlacement selects the edge clusters a Mission should be sent to.
+// The target set is the union of ClusterNames and every cluster matched by
+// ClusterSelector.
+type MissionPlacement struct {
+	// ClusterNames explicitly lists target edge cluster names.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+
+	// ClusterSelector matches edge clusters by label, mirroring the
+	// federated placement model used by kubeadmiral.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+}
+
+// MissionStatus is the observed state of a Mission.
+type MissionStatus struct {
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MissionList is a list of Missions.
+type MissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Mission `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EdgeCluster represents an edge cluster registered with the cloud.
+type EdgeCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec  EdgeClusterSpec  `json:"spec,omitempty"`
+	State EdgeClusterState `json:"state,omitempty"`
+}
+
+// EdgeClusterSpec is the desired state of an EdgeCluster.
+type EdgeClusterSpec struct {
+}
+
+// EdgeClusterState is the last-reported state of an EdgeCluster.
+type EdgeClusterState struct {
+	// ReceivedMissions lists the names of the missions this edge cluster has
+	// last reported as received.
+	ReceivedMissions []string `json:"receivedMissions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EdgeClusterList is a list of EdgeClusters.
+type EdgeClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []EdgeCluster `json:"items"`
+}
@@ -2,15 +2,23 @@ package controller
 
 import (
 	"context"
-	"reflect"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	k8sinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	clientgov1 "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
 	beehiveContext "github.com/kubeedge/beehive/pkg/core/context"
@@ -28,6 +36,123 @@ import (
 	"github.com/kubeedge/kubeedge/cloud/pkg/edgecontroller/messagelayer"
 )
 
+// maxMessageRetries bounds how many times a downstream message is retried
+// against the message layer before it is dropped.
+const maxMessageRetries = 5
+
+// edgeClusterMissionResyncInterval bounds how long an edge cluster's mission
+// set is trusted to stay correct from incremental diffs alone. Past this
+// interval syncEdgeClusters falls back to a full snapshot even without a new
+// watch event, so drift from a dropped/coalesced message (e.g. one the
+// retry queue gave up on after maxMessageRetries) eventually self-heals.
+const edgeClusterMissionResyncInterval = 5 * time.Minute
+
+var (
+	downstreamQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: "edgecontroller",
+		Name:      "downstream_queue_depth",
+		Help:      "Current depth of the downstream message queue, by resource kind.",
+	}, []string{"kind"})
+
+	downstreamRetryTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "edgecontroller",
+		Name:      "downstream_message_retry_total",
+		Help:      "Total downstream message send retries, by resource kind.",
+	}, []string{"kind"})
+
+	downstreamDropTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "edgecontroller",
+		Name:      "downstream_message_drop_total",
+		Help:      "Total downstream messages dropped after exhausting retries, by resource kind.",
+	}, []string{"kind"})
+)
+
+func init() {
+	prometheus.MustRegister(downstreamQueueDepth, downstreamRetryTotal, downstreamDropTotal)
+}
+
+// knownControllers enumerates every downstream sync subsystem that can be
+// toggled via --controllers, mirroring the knownControllers/
+// controllersDisabledByDefault registry pattern used by federated control
+// planes to let an operator enable only the subsystems a given cloud needs.
+var knownControllers = []string{
+	"pod", "configmap", "secret", "node", "rule", "ruleendpoint", "mission", "edgecluster",
+}
+
+// controllersDisabledByDefault lists controllers that must be explicitly
+// opted into with a leading '+' even though they're in knownControllers.
+var controllersDisabledByDefault = sets.NewString()
+
+// ParseControllerFlags turns a --controllers=+mission,-rule style flag value
+// into the resolved set of enabled controller names. An empty raw value
+// enables every known controller except those disabled by default. A bare
+// (unprefixed) token names a controller explicitly: once one is present the
+// defaults no longer apply, so "mission,edgecluster" enables only those two.
+func ParseControllerFlags(raw string) sets.String {
+	all := sets.NewString(knownControllers...)
+	enabled := all.Difference(controllersDisabledByDefault)
+
+	tokens := strings.Split(raw, ",")
+	for _, token := range tokens {
+		token = strings.TrimSpace(token)
+		if token != "" && token[0] != '+' && token[0] != '-' {
+			enabled = sets.NewString()
+			break
+		}
+	}
+
+	for _, token := range tokens {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+		switch token[0] {
+		case '+':
+			enabled.Insert(token[1:])
+		case '-':
+			enabled.Delete(token[1:])
+		default:
+			enabled.Insert(token)
+		}
+	}
+
+	return enabled.Intersection(all)
+}
+
+// messageQueue rate-limits and retries delivery of downstream messages for a
+// single resource kind. Sync loops enqueue by key instead of calling
+// SendMessage directly, so a transient CloudHub error no longer silently
+// drops the update: the item is requeued with exponential backoff, and
+// redundant updates to the same key are coalesced into the latest message.
+type messageQueue struct {
+	kind  string
+	queue workqueue.RateLimitingInterface
+
+	mu      sync.Mutex
+	pending map[string]*model.Message
+}
+
+func newMessageQueue(kind string) *messageQueue {
+	return &messageQueue{
+		kind:    kind,
+		queue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), kind),
+		pending: make(map[string]*model.Message),
+	}
+}
+
+func (q *messageQueue) Add(key string, msg *model.Message) {
+	q.mu.Lock()
+	q.pending[key] = msg
+	q.mu.Unlock()
+
+	q.queue.Add(key)
+	downstreamQueueDepth.WithLabelValues(q.kind).Set(float64(q.queue.Len()))
+}
+
+func (q *messageQueue) ShutDown() {
+	q.queue.ShutDown()
+}
+
 // DownstreamController watch kubernetes api server and send change to edge
 type DownstreamController struct {
 	kubeClient kubernetes.Interface
@@ -48,6 +173,10 @@ type DownstreamController struct {
 
 	endpointsManager *manager.EndpointsManager
 
+	// endpointSliceManager is nil unless EndpointSlice sync is enabled; it
+	// coexists with endpointsManager above during rollout to discovery.k8s.io/v1.
+	endpointSliceManager *manager.EndpointSliceManager
+
 	rulesManager *manager.RuleManager
 
 	ruleEndpointsManager *manager.RuleEndpointManager
@@ -63,6 +192,35 @@ type DownstreamController struct {
 	podLister clientgov1.PodLister
 
 	missionLister crdlister.MissionLister
+
+	edgeClusterLister crdlister.EdgeClusterLister
+
+	// endpointSliceLister is nil unless EndpointSlice sync is enabled; it lets
+	// syncEndpointSlices aggregate every slice for a service instead of
+	// forwarding only the one that triggered the event.
+	endpointSliceLister discoverylisters.EndpointSliceLister
+
+	podQueue *messageQueue
+
+	configMapQueue *messageQueue
+
+	secretQueue *messageQueue
+
+	nodeQueue *messageQueue
+
+	ruleQueue *messageQueue
+
+	ruleEndpointQueue *messageQueue
+
+	missionQueue *messageQueue
+
+	edgeClusterQueue *messageQueue
+
+	endpointSliceQueue *messageQueue
+
+	enabledControllers sets.String
+
+	endpointSlicesEnabled bool
 }
 
 func (dc *DownstreamController) syncPod() {
@@ -102,7 +260,7 @@ func (dc *DownstreamController) syncPod() {
 				continue
 			}
 
-			dc.SendMessage(msg)
+			dc.podQueue.Add(fmt.Sprintf("%s/%s/%s", pod.Spec.NodeName, pod.Namespace, pod.Name), msg)
 		}
 	}
 }
@@ -149,7 +307,7 @@ func (dc *DownstreamController) syncConfigMap() {
 					BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation).
 					FillBody(configMap)
 
-				dc.SendMessage(msg)
+				dc.configMapQueue.Add(fmt.Sprintf("%s/%s/%s", n, configMap.Namespace, configMap.Name), msg)
 			}
 		}
 	}
@@ -198,12 +356,107 @@ func (dc *DownstreamController) syncSecret() {
 					BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation).
 					FillBody(secret)
 
-				dc.SendMessage(msg)
+				dc.secretQueue.Add(fmt.Sprintf("%s/%s/%s", n, secret.Namespace, secret.Name), msg)
 			}
 		}
 	}
 }
 
+// syncEndpointSlices mirrors syncSecret's node-fanout shape but resolves
+// targets through the owning Service rather than dc.lc's per-resource index,
+// since an EndpointSlice's own name is meaningless to the edge. Slice events
+// are coalesced per service so an edge node gets one message per service
+// change rather than one per slice mutation. Because a service can be backed
+// by more than one EndpointSlice, each message body carries every slice for
+// the service rather than just the one that triggered the event, so a later
+// change to one slice can't make the edge forget another slice's endpoints.
+func (dc *DownstreamController) syncEndpointSlices() {
+	for {
+		select {
+		case <-beehiveContext.Done():
+			klog.Warning("Stop edgecontroller downstream syncEndpointSlices loop")
+			return
+		case e := <-dc.endpointSliceManager.Events():
+			slice, ok := e.Object.(*discoveryv1.EndpointSlice)
+			if !ok {
+				klog.Warningf("object type: %T unsupported", e.Object)
+				continue
+			}
+			serviceName, ok := slice.Labels[discoveryv1.LabelServiceName]
+			if !ok {
+				klog.Warningf("endpointslice %s/%s has no %s label, skipping", slice.Namespace, slice.Name, discoveryv1.LabelServiceName)
+				continue
+			}
+
+			switch e.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+			default:
+				klog.Warningf("endpointslice event type: %s unsupported", e.Type)
+				continue
+			}
+
+			selector := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: serviceName})
+			slices, err := dc.endpointSliceLister.EndpointSlices(slice.Namespace).List(selector)
+			if err != nil {
+				klog.Warningf("list endpointslices for service %s/%s failed with error: %s", slice.Namespace, serviceName, err)
+				continue
+			}
+			if e.Type != watch.Deleted {
+				// the triggering slice may not have reached the lister's cache
+				// yet; fold it in so the snapshot never misses the event that
+				// woke this loop up
+				slices = appendEndpointSlice(slices, slice)
+			}
+
+			// The message carries every slice still backing the service, not
+			// just the one that triggered this event, so the operation must
+			// reflect whether any slices remain rather than the raw event
+			// type: deleting one of several slices for a service is an
+			// Update (the other slices' endpoints are still valid), and only
+			// the last slice going away is a real Delete.
+			operation := endpointSliceOperation(slices)
+
+			nodes := dc.lc.ServiceNodes(slice.Namespace, serviceName)
+			klog.V(4).Infof("there are %d nodes need to sync %d endpointslices for service %s/%s, operation: %s", len(nodes), len(slices), slice.Namespace, serviceName, e.Type)
+			for _, n := range nodes {
+				resource, err := messagelayer.BuildResource(n, slice.Namespace, model.ResourceTypeEndpointSlice, serviceName)
+				if err != nil {
+					klog.Warningf("build message resource failed with error: %s", err)
+					continue
+				}
+				msg := model.NewMessage("").
+					BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation).
+					FillBody(slices)
+
+				dc.endpointSliceQueue.Add(fmt.Sprintf("%s/%s/%s", n, slice.Namespace, serviceName), msg)
+			}
+		}
+	}
+}
+
+// appendEndpointSlice returns slices with new appended, replacing any
+// existing entry of the same name so the lister's (possibly stale) copy
+// never shadows the slice that triggered the current event.
+func appendEndpointSlice(slices []*discoveryv1.EndpointSlice, new *discoveryv1.EndpointSlice) []*discoveryv1.EndpointSlice {
+	for i, s := range slices {
+		if s.Name == new.Name {
+			out := append([]*discoveryv1.EndpointSlice{}, slices...)
+			out[i] = new
+			return out
+		}
+	}
+	return append(slices, new)
+}
+
+// endpointSliceOperation returns the operation for a message carrying the
+// slices still backing a service: Delete only when none remain, else Update.
+func endpointSliceOperation(slices []*discoveryv1.EndpointSlice) string {
+	if len(slices) == 0 {
+		return model.DeleteOperation
+	}
+	return model.UpdateOperation
+}
+
 func (dc *DownstreamController) syncEdgeNodes() {
 	for {
 		select {
@@ -242,7 +495,7 @@ func (dc *DownstreamController) syncEdgeNodes() {
 				}
 				msg := model.NewMessage("").
 					BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.DeleteOperation)
-				dc.SendMessage(msg)
+				dc.nodeQueue.Add(node.Name, msg)
 			default:
 				// unsupported operation, no need to send to any node
 				klog.Warningf("Node event type: %s unsupported", e.Type)
@@ -251,6 +504,26 @@ func (dc *DownstreamController) syncEdgeNodes() {
 	}
 }
 
+// resyncOperation derives the message operation for a Rule/RuleEndpoint
+// event, and whether the event should be skipped entirely: a Modified event
+// that reports the same ResourceVersion already observed is a no-op resync
+// from the informer rather than a real change.
+func resyncOperation(eventType watch.EventType, observedVersion, currentVersion string) (operation string, skip bool) {
+	switch eventType {
+	case watch.Added:
+		return model.InsertOperation, false
+	case watch.Deleted:
+		return model.DeleteOperation, false
+	case watch.Modified:
+		if observedVersion == currentVersion {
+			return "", true
+		}
+		return model.UpdateOperation, false
+	default:
+		return "", true
+	}
+}
+
 func (dc *DownstreamController) syncRule() {
 	for {
 		select {
@@ -271,22 +544,24 @@ func (dc *DownstreamController) syncRule() {
 				klog.Warningf("built message resource failed with error: %s", err)
 				continue
 			}
+			operation, skip := resyncOperation(e.Type, dc.lc.RuleResourceVersion(rule.Name), rule.ResourceVersion)
+			if skip {
+				if e.Type != watch.Added && e.Type != watch.Deleted && e.Type != watch.Modified {
+					klog.Warningf("rule event type: %s unsupported", e.Type)
+				}
+				continue
+			}
 			msg := model.NewMessage("").
 				SetResourceVersion(rule.ResourceVersion).
-				FillBody(rule)
-			switch e.Type {
-			case watch.Added:
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.InsertOperation)
-			case watch.Deleted:
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.DeleteOperation)
-			case watch.Modified:
-				klog.Warningf("rule event type: %s unsupported", e.Type)
-				continue
-			default:
-				klog.Warningf("rule event type: %s unsupported", e.Type)
-				continue
+				FillBody(rule).
+				BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation)
+
+			if e.Type == watch.Deleted {
+				dc.lc.DeleteRuleResourceVersion(rule.Name)
+			} else {
+				dc.lc.SetRuleResourceVersion(rule.Name, rule.ResourceVersion)
 			}
-			dc.SendMessage(msg)
+			dc.ruleQueue.Add(rule.Name, msg)
 		}
 	}
 }
@@ -311,29 +586,29 @@ func (dc *DownstreamController) syncRuleEndpoint() {
 				klog.Warningf("built message resource failed with error: %s", err)
 				continue
 			}
-			msg := model.NewMessage("").
-				SetResourceVersion(ruleEndpoint.ResourceVersion).
-				FillBody(ruleEndpoint)
-			switch e.Type {
-			case watch.Added:
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.InsertOperation)
-			case watch.Deleted:
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.DeleteOperation)
-			case watch.Modified:
-				klog.Warningf("ruleEndpoint event type: %s unsupported", e.Type)
-				continue
-			default:
-				klog.Warningf("ruleEndpoint event type: %s unsupported", e.Type)
+			operation, skip := resyncOperation(e.Type, dc.lc.RuleEndpointResourceVersion(ruleEndpoint.Name), ruleEndpoint.ResourceVersion)
+			if skip {
+				if e.Type != watch.Added && e.Type != watch.Deleted && e.Type != watch.Modified {
+					klog.Warningf("ruleEndpoint event type: %s unsupported", e.Type)
+				}
 				continue
 			}
+			msg := model.NewMessage("").
+				SetResourceVersion(ruleEndpoint.ResourceVersion).
+				FillBody(ruleEndpoint).
+				BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation)
 
-			dc.SendMessage(msg)
+			if e.Type == watch.Deleted {
+				dc.lc.DeleteRuleEndpointResourceVersion(ruleEndpoint.Name)
+			} else {
+				dc.lc.SetRuleEndpointResourceVersion(ruleEndpoint.Name, ruleEndpoint.ResourceVersion)
+			}
+			dc.ruleEndpointQueue.Add(ruleEndpoint.Name, msg)
 		}
 	}
 }
 
 func (dc *DownstreamController) syncMissions() {
-	var operation string
 	for {
 		select {
 		case <-beehiveContext.Done():
@@ -347,49 +622,112 @@ func (dc *DownstreamController) syncMissions() {
 				continue
 			}
 			klog.V(4).Infof("Get mission events: mission object: %+v.", mission)
+
+			if e.Type == watch.Deleted {
+				dc.sendMissionToClusters(mission, dc.lc.MissionTargetClusters(mission.Name), model.DeleteOperation)
+				dc.lc.DeleteMissionTargets(mission.Name)
+				continue
+			}
+
+			var operation string
 			switch e.Type {
 			case watch.Added:
 				operation = model.InsertOperation
 			case watch.Modified:
 				operation = model.UpdateOperation
-			case watch.Deleted:
-				operation = model.DeleteOperation
 			default:
 				// unsupported operation, no need to send to any node
 				klog.Warningf("Mission event type: %s unsupported", e.Type)
 				continue
 			}
 
-			// send to all nodes
-			dc.lc.EdgeClusters.Range(func(key interface{}, value interface{}) bool {
-				clusterName, ok := key.(string)
-				if !ok {
-					klog.Warning("Failed to assert key to sting")
-					return true
-				}
-				msg := model.NewMessage("")
-				msg.SetResourceVersion(mission.ResourceVersion)
-				resource, err := messagelayer.BuildResource(clusterName, "default", model.ResourceTypeMission, mission.Name)
-				if err != nil {
-					klog.Warningf("Built message resource failed with error: %v", err)
-					return true
-				}
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation)
-				msg.Content = mission
+			targets, err := dc.resolveMissionTargets(mission)
+			if err != nil {
+				klog.Warningf("resolve mission target clusters for %s failed with error: %s", mission.Name, err)
+				continue
+			}
+
+			dc.sendMissionToClusters(mission, targets, operation)
+
+			// clusters that matched before but no longer match must be told to
+			// drop the mission instead of silently keeping a stale copy
+			stale := dc.lc.MissionTargetClusters(mission.Name).Difference(targets)
+			dc.sendMissionToClusters(mission, stale, model.DeleteOperation)
+
+			dc.lc.UpdateMissionTargets(mission.Name, targets)
+		}
+	}
+}
+
+// resolveMissionTargets computes the set of edge clusters a mission should be
+// sent to, from its explicit ClusterNames plus any cluster matching its
+// ClusterSelector.
+func (dc *DownstreamController) resolveMissionTargets(mission *edgeclustersv1.Mission) (sets.String, error) {
+	targets := sets.NewString(mission.Spec.Placement.ClusterNames...)
+
+	if mission.Spec.Placement.ClusterSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(mission.Spec.Placement.ClusterSelector)
+		if err != nil {
+			return nil, err
+		}
+		clusters, err := dc.edgeClusterLister.List(selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			targets.Insert(cluster.Name)
+		}
+	}
+
+	return targets, nil
+}
 
-				dc.SendMessage(msg)
-				return true
-			})
+func (dc *DownstreamController) sendMissionToClusters(mission *edgeclustersv1.Mission, clusters sets.String, operation string) {
+	for _, clusterName := range clusters.List() {
+		resource, err := messagelayer.BuildResource(clusterName, "default", model.ResourceTypeMission, mission.Name)
+		if err != nil {
+			klog.Warningf("Built message resource failed with error: %v", err)
+			continue
+		}
+		msg := model.NewMessage("").
+			SetResourceVersion(mission.ResourceVersion).
+			BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation)
+		msg.Content = mission
+
+		dc.missionQueue.Add(fmt.Sprintf("%s/%s", clusterName, mission.Name), msg)
+	}
+}
+
+// missionDelta computes which missions an edge cluster needs inserted,
+// updated, or deleted, given the set of missions the cloud currently has and
+// the set the edge last reported receiving. resourceVersionOf looks up the
+// edge's last-observed ResourceVersion for a given mission name.
+func missionDelta(missionsInCloud, missionsInEdge sets.String, missionByName map[string]*edgeclustersv1.Mission, resourceVersionOf func(name string) string) (toAdd, toUpdate, toRemove sets.String) {
+	toAdd = missionsInCloud.Difference(missionsInEdge)
+	toRemove = missionsInEdge.Difference(missionsInCloud)
+	toUpdate = sets.String{}
+	for name := range missionsInCloud.Intersection(missionsInEdge) {
+		if resourceVersionOf(name) != missionByName[name].ResourceVersion {
+			toUpdate.Insert(name)
 		}
 	}
+	return toAdd, toUpdate, toRemove
 }
 
 func (dc *DownstreamController) syncEdgeClusters() {
+	resyncTicker := time.NewTicker(edgeClusterMissionResyncInterval)
+	defer resyncTicker.Stop()
+
 	for {
 		select {
 		case <-beehiveContext.Done():
 			klog.Warning("Stop edgecontroller downstream syncEdgeCluster loop")
 			return
+		case <-resyncTicker.C:
+			// periodic resync: correct drift that isn't driven by a new watch
+			// event, e.g. a message the retry queue dropped after exhausting
+			// maxMessageRetries
+			dc.resyncStaleEdgeClusters()
 		case e := <-dc.edgeClusterManager.Events():
 			klog.V(4).Infof("Get edgeCluster events: event type: %s.", e.Type)
 			edgeCluster, ok := e.Object.(*edgeclustersv1.EdgeCluster)
@@ -402,35 +740,48 @@ func (dc *DownstreamController) syncEdgeClusters() {
 			case watch.Added:
 				fallthrough
 			case watch.Modified:
-				missionsInEdge := edgeCluster.State.ReceivedMissions
-				missionsInEdgeSet := map[string]bool{}
-				for _, m := range missionsInEdge {
-					missionsInEdgeSet[m] = true
-				}
+				missionsInEdgeSet := sets.NewString(edgeCluster.State.ReceivedMissions...)
 
-				missionsInCloudSet := map[string]bool{}
 				missionList, err := dc.missionLister.List(labels.Everything())
 				if err != nil {
 					klog.Warningf("Built message resource failed with error: %s", err)
 					break
 				}
+				missionsInCloudSet := sets.String{}
+				missionByName := make(map[string]*edgeclustersv1.Mission, len(missionList))
 				for _, m := range missionList {
-					missionsInCloudSet[m.Name] = true
+					missionsInCloudSet.Insert(m.Name)
+					missionByName[m.Name] = m
 				}
 
-				if reflect.DeepEqual(missionsInEdgeSet, missionsInCloudSet) {
+				if !dc.lc.HasObservedEdgeCluster(edgeCluster.Name) || dc.lc.EdgeClusterMissionResyncDue(edgeCluster.Name, edgeClusterMissionResyncInterval) {
+					// unknown edge state, or the resync interval elapsed without a
+					// diff-able event in between: fall back to a full snapshot so
+					// the edge doesn't have to re-diff against a partial stream
+					dc.sendMissionListSnapshot(edgeCluster.Name, missionList)
+					dc.lc.SetObservedMissions(edgeCluster.Name, missionVersions(missionList))
 					break
 				}
 
-				msg := model.NewMessage("")
-				resource, err := messagelayer.BuildResource(edgeCluster.Name, "default", model.ResourceTypeMissionList, "")
-				msg.BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.UpdateOperation)
-				msg.Content = missionList
+				toAdd, toUpdate, toRemove := missionDelta(missionsInCloudSet, missionsInEdgeSet, missionByName, func(name string) string {
+					return dc.lc.MissionResourceVersion(edgeCluster.Name, name)
+				})
 
-				dc.SendMessage(msg)
+				for _, name := range toAdd.List() {
+					dc.sendEdgeClusterMission(edgeCluster.Name, missionByName[name], model.InsertOperation)
+				}
+				for _, name := range toUpdate.List() {
+					dc.sendEdgeClusterMission(edgeCluster.Name, missionByName[name], model.UpdateOperation)
+				}
+				for _, name := range toRemove.List() {
+					dc.deleteEdgeClusterMission(edgeCluster.Name, name)
+				}
+
+				dc.lc.SetObservedMissions(edgeCluster.Name, missionVersions(missionList))
 
 			case watch.Deleted:
 				dc.lc.DeleteEdgeCluster(edgeCluster.ObjectMeta.Name)
+				dc.lc.ClearObservedMissions(edgeCluster.ObjectMeta.Name)
 
 			default:
 				// unsupported operation, no need to send to any node
@@ -441,32 +792,138 @@ func (dc *DownstreamController) syncEdgeClusters() {
 	}
 }
 
+// resyncStaleEdgeClusters sends a full mission snapshot to every registered
+// edge cluster whose resync interval has elapsed, so drift from a dropped or
+// coalesced message eventually self-heals even without a new watch event.
+func (dc *DownstreamController) resyncStaleEdgeClusters() {
+	edgeClusters, err := dc.edgeClusterLister.List(labels.Everything())
+	if err != nil {
+		klog.Warningf("List edge clusters failed with error: %s", err)
+		return
+	}
+
+	var missionList []*edgeclustersv1.Mission
+	for _, edgeCluster := range edgeClusters {
+		if dc.lc.HasObservedEdgeCluster(edgeCluster.Name) && !dc.lc.EdgeClusterMissionResyncDue(edgeCluster.Name, edgeClusterMissionResyncInterval) {
+			continue
+		}
+
+		if missionList == nil {
+			missionList, err = dc.missionLister.List(labels.Everything())
+			if err != nil {
+				klog.Warningf("Built message resource failed with error: %s", err)
+				return
+			}
+		}
+
+		dc.sendMissionListSnapshot(edgeCluster.Name, missionList)
+		dc.lc.SetObservedMissions(edgeCluster.Name, missionVersions(missionList))
+	}
+}
+
+// sendMissionListSnapshot ships the full mission list to an edge cluster, for
+// use when the edge's reported state can't be diffed against incrementally.
+func (dc *DownstreamController) sendMissionListSnapshot(clusterName string, missionList []*edgeclustersv1.Mission) {
+	resource, err := messagelayer.BuildResource(clusterName, "default", model.ResourceTypeMissionList, "")
+	if err != nil {
+		klog.Warningf("Built message resource failed with error: %s", err)
+		return
+	}
+	msg := model.NewMessage("").
+		BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.UpdateOperation)
+	msg.Content = missionList
+
+	dc.edgeClusterQueue.Add(clusterName, msg)
+}
+
+func (dc *DownstreamController) sendEdgeClusterMission(clusterName string, mission *edgeclustersv1.Mission, operation string) {
+	resource, err := messagelayer.BuildResource(clusterName, "default", model.ResourceTypeMission, mission.Name)
+	if err != nil {
+		klog.Warningf("Built message resource failed with error: %s", err)
+		return
+	}
+	msg := model.NewMessage("").
+		SetResourceVersion(mission.ResourceVersion).
+		BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, operation)
+	msg.Content = mission
+
+	dc.missionQueue.Add(fmt.Sprintf("%s/%s", clusterName, mission.Name), msg)
+}
+
+func (dc *DownstreamController) deleteEdgeClusterMission(clusterName, missionName string) {
+	resource, err := messagelayer.BuildResource(clusterName, "default", model.ResourceTypeMission, missionName)
+	if err != nil {
+		klog.Warningf("Built message resource failed with error: %s", err)
+		return
+	}
+	msg := model.NewMessage("").
+		BuildRouter(modules.EdgeControllerModuleName, constants.GroupResource, resource, model.DeleteOperation)
+
+	dc.missionQueue.Add(fmt.Sprintf("%s/%s", clusterName, missionName), msg)
+}
+
+// missionVersions indexes a mission list by name to its ResourceVersion, so
+// LocationCache can detect which missions an edge cluster already has an
+// up-to-date copy of.
+func missionVersions(missionList []*edgeclustersv1.Mission) map[string]string {
+	versions := make(map[string]string, len(missionList))
+	for _, m := range missionList {
+		versions[m.Name] = m.ResourceVersion
+	}
+	return versions
+}
+
 // Start DownstreamController
 func (dc *DownstreamController) Start() error {
 	klog.Info("start downstream controller")
-	// pod
-	go dc.syncPod()
 
-	// configmap
-	go dc.syncConfigMap()
+	if dc.enabledControllers.Has("pod") {
+		go dc.syncPod()
+		go dc.runMessageQueue(dc.podQueue)
+	}
+
+	if dc.enabledControllers.Has("configmap") {
+		go dc.syncConfigMap()
+		go dc.runMessageQueue(dc.configMapQueue)
+	}
+
+	if dc.enabledControllers.Has("secret") {
+		go dc.syncSecret()
+		go dc.runMessageQueue(dc.secretQueue)
+	}
 
-	// secret
-	go dc.syncSecret()
+	if dc.enabledControllers.Has("node") {
+		go dc.syncEdgeNodes()
+		go dc.runMessageQueue(dc.nodeQueue)
+	}
 
-	// nodes
-	go dc.syncEdgeNodes()
+	if dc.enabledControllers.Has("rule") {
+		go dc.syncRule()
+		go dc.runMessageQueue(dc.ruleQueue)
+	}
 
-	// rule
-	go dc.syncRule()
+	if dc.enabledControllers.Has("ruleendpoint") {
+		go dc.syncRuleEndpoint()
+		go dc.runMessageQueue(dc.ruleEndpointQueue)
+	}
 
-	// ruleendpoint
-	go dc.syncRuleEndpoint()
+	if dc.enabledControllers.Has("mission") {
+		go dc.syncMissions()
+		go dc.runMessageQueue(dc.missionQueue)
+	}
 
-	// mission
-	go dc.syncMissions()
+	if dc.enabledControllers.Has("edgecluster") {
+		go dc.syncEdgeClusters()
+		go dc.runMessageQueue(dc.edgeClusterQueue)
+		if !dc.enabledControllers.Has("mission") {
+			go dc.runMessageQueue(dc.missionQueue)
+		}
+	}
 
-	// edgecluster
-	go dc.syncEdgeClusters()
+	if dc.endpointSlicesEnabled {
+		go dc.syncEndpointSlices()
+		go dc.runMessageQueue(dc.endpointSliceQueue)
+	}
 
 	return nil
 }
@@ -513,36 +970,70 @@ func (dc *DownstreamController) initLocating() error {
 	return nil
 }
 
-// NewDownstreamController create a DownstreamController from config
+// NewDownstreamController create a DownstreamController from config. controllers
+// is a --controllers=+mission,-rule style flag value; see ParseControllerFlags.
+// Informers and managers for a disabled controller are never constructed, so
+// a cloud that isn't managing, say, individual pods doesn't pay for their
+// watch/cache overhead. enableEndpointSlices gates the discovery.k8s.io/v1
+// EndpointSlice sync path, which coexists with the legacy Endpoints path
+// below during rollout.
 func NewDownstreamController(k8sInformerFactory k8sinformers.SharedInformerFactory, keInformerFactory informers.KubeEdgeCustomInformer,
-	crdInformerFactory crdinformers.SharedInformerFactory) (*DownstreamController, error) {
+	crdInformerFactory crdinformers.SharedInformerFactory, controllers string, enableEndpointSlices bool) (*DownstreamController, error) {
 	lc := &manager.LocationCache{}
+	enabled := ParseControllerFlags(controllers)
 
-	podInformer := k8sInformerFactory.Core().V1().Pods()
-	podManager, err := manager.NewPodManager(podInformer.Informer())
-	if err != nil {
-		klog.Warningf("create pod manager failed with error: %s", err)
-		return nil, err
+	dc := &DownstreamController{
+		kubeClient:            client.GetKubeClient(),
+		crdClient:             client.GetCRDClient(),
+		messageLayer:          messagelayer.NewContextMessageLayer(),
+		lc:                    lc,
+		enabledControllers:    enabled,
+		endpointSlicesEnabled: enableEndpointSlices,
 	}
 
-	configMapInformer := k8sInformerFactory.Core().V1().ConfigMaps()
-	configMapManager, err := manager.NewConfigMapManager(configMapInformer.Informer())
-	if err != nil {
-		klog.Warningf("create configmap manager failed with error: %s", err)
-		return nil, err
+	if enabled.Has("pod") {
+		podInformer := k8sInformerFactory.Core().V1().Pods()
+		podManager, err := manager.NewPodManager(podInformer.Informer())
+		if err != nil {
+			klog.Warningf("create pod manager failed with error: %s", err)
+			return nil, err
+		}
+		dc.podManager = podManager
+		dc.podLister = podInformer.Lister()
+		dc.podQueue = newMessageQueue("pod")
 	}
 
-	secretInformer := k8sInformerFactory.Core().V1().Secrets()
-	secretManager, err := manager.NewSecretManager(secretInformer.Informer())
-	if err != nil {
-		klog.Warningf("create secret manager failed with error: %s", err)
-		return nil, err
+	if enabled.Has("configmap") {
+		configMapInformer := k8sInformerFactory.Core().V1().ConfigMaps()
+		configMapManager, err := manager.NewConfigMapManager(configMapInformer.Informer())
+		if err != nil {
+			klog.Warningf("create configmap manager failed with error: %s", err)
+			return nil, err
+		}
+		dc.configmapManager = configMapManager
+		dc.configMapQueue = newMessageQueue("configmap")
 	}
-	nodeInformer := keInformerFactory.EdgeNode()
-	nodesManager, err := manager.NewNodesManager(nodeInformer)
-	if err != nil {
-		klog.Warningf("Create nodes manager failed with error: %s", err)
-		return nil, err
+
+	if enabled.Has("secret") {
+		secretInformer := k8sInformerFactory.Core().V1().Secrets()
+		secretManager, err := manager.NewSecretManager(secretInformer.Informer())
+		if err != nil {
+			klog.Warningf("create secret manager failed with error: %s", err)
+			return nil, err
+		}
+		dc.secretManager = secretManager
+		dc.secretQueue = newMessageQueue("secret")
+	}
+
+	if enabled.Has("node") {
+		nodeInformer := keInformerFactory.EdgeNode()
+		nodesManager, err := manager.NewNodesManager(nodeInformer)
+		if err != nil {
+			klog.Warningf("Create nodes manager failed with error: %s", err)
+			return nil, err
+		}
+		dc.nodeManager = nodesManager
+		dc.nodeQueue = newMessageQueue("node")
 	}
 
 	svcInformer := k8sInformerFactory.Core().V1().Services()
@@ -551,6 +1042,8 @@ func NewDownstreamController(k8sInformerFactory k8sinformers.SharedInformerFacto
 		klog.Warningf("Create service manager failed with error: %s", err)
 		return nil, err
 	}
+	dc.serviceManager = serviceManager
+	dc.svcLister = svcInformer.Lister()
 
 	endpointsInformer := k8sInformerFactory.Core().V1().Endpoints()
 	endpointsManager, err := manager.NewEndpointsManager(endpointsInformer.Informer())
@@ -558,54 +1051,78 @@ func NewDownstreamController(k8sInformerFactory k8sinformers.SharedInformerFacto
 		klog.Warningf("Create endpoints manager failed with error: %s", err)
 		return nil, err
 	}
-
-	rulesInformer := crdInformerFactory.Rules().V1().Rules().Informer()
-	rulesManager, err := manager.NewRuleManager(rulesInformer)
-	if err != nil {
-		klog.Warningf("Create rulesManager failed with error: %s", err)
-		return nil, err
+	dc.endpointsManager = endpointsManager
+
+	if enableEndpointSlices {
+		endpointSliceInformer := k8sInformerFactory.Discovery().V1().EndpointSlices()
+		endpointSliceManager, err := manager.NewEndpointSliceManager(endpointSliceInformer.Informer())
+		if err != nil {
+			klog.Warningf("Create endpointSlice manager failed with error: %s", err)
+			return nil, err
+		}
+		dc.endpointSliceManager = endpointSliceManager
+		dc.endpointSliceLister = endpointSliceInformer.Lister()
+		dc.endpointSliceQueue = newMessageQueue("endpointslice")
 	}
 
-	ruleEndpointsInformer := crdInformerFactory.Rules().V1().RuleEndpoints().Informer()
-	ruleEndpointsManager, err := manager.NewRuleEndpointManager(ruleEndpointsInformer)
-	if err != nil {
-		klog.Warningf("Create ruleEndpointsManager failed with error: %s", err)
-		return nil, err
+	if enabled.Has("rule") {
+		rulesInformer := crdInformerFactory.Rules().V1().Rules().Informer()
+		rulesManager, err := manager.NewRuleManager(rulesInformer)
+		if err != nil {
+			klog.Warningf("Create rulesManager failed with error: %s", err)
+			return nil, err
+		}
+		dc.rulesManager = rulesManager
+		dc.ruleQueue = newMessageQueue("rule")
 	}
 
-	missionsInformer := crdInformerFactory.Edgeclusters().V1().Missions()
-	missionsManager, err := manager.NewMissionManager(missionsInformer.Informer())
-	if err != nil {
-		klog.Warningf("Create missionsManager failed with error: %s", err)
-		return nil, err
+	if enabled.Has("ruleendpoint") {
+		ruleEndpointsInformer := crdInformerFactory.Rules().V1().RuleEndpoints().Informer()
+		ruleEndpointsManager, err := manager.NewRuleEndpointManager(ruleEndpointsInformer)
+		if err != nil {
+			klog.Warningf("Create ruleEndpointsManager failed with error: %s", err)
+			return nil, err
+		}
+		dc.ruleEndpointsManager = ruleEndpointsManager
+		dc.ruleEndpointQueue = newMessageQueue("ruleendpoint")
 	}
 
-	edgeClustersInformer := crdInformerFactory.Edgeclusters().V1().EdgeClusters()
-	edgeClusterManager, err := manager.NewEdgeClusterManager(edgeClustersInformer.Informer())
-	if err != nil {
-		klog.Warningf("Create edgeClusterManager failed with error: %s", err)
-		return nil, err
+	if enabled.Has("mission") || enabled.Has("edgecluster") {
+		// syncEdgeClusters diffs against the mission lister even when the
+		// mission controller itself is disabled, so the lister has to be
+		// built whenever either controller is on.
+		missionsInformer := crdInformerFactory.Edgeclusters().V1().Missions()
+		dc.missionLister = missionsInformer.Lister()
+		// syncEdgeClusters also sends per-mission messages through missionQueue
+		dc.missionQueue = newMessageQueue("mission")
+
+		if enabled.Has("mission") {
+			missionsManager, err := manager.NewMissionManager(missionsInformer.Informer())
+			if err != nil {
+				klog.Warningf("Create missionsManager failed with error: %s", err)
+				return nil, err
+			}
+			dc.missionsManager = missionsManager
+		}
 	}
 
-	dc := &DownstreamController{
-		kubeClient:           client.GetKubeClient(),
-		crdClient:            client.GetCRDClient(),
-		podManager:           podManager,
-		configmapManager:     configMapManager,
-		secretManager:        secretManager,
-		nodeManager:          nodesManager,
-		serviceManager:       serviceManager,
-		endpointsManager:     endpointsManager,
-		messageLayer:         messagelayer.NewContextMessageLayer(),
-		lc:                   lc,
-		svcLister:            svcInformer.Lister(),
-		podLister:            podInformer.Lister(),
-		rulesManager:         rulesManager,
-		ruleEndpointsManager: ruleEndpointsManager,
-		missionsManager:      missionsManager,
-		edgeClusterManager:   edgeClusterManager,
-		missionLister:        missionsInformer.Lister(),
+	if enabled.Has("edgecluster") || enabled.Has("mission") {
+		// the mission controller resolves ClusterSelector placement against
+		// the edge cluster lister even when edgecluster sync itself is off
+		edgeClustersInformer := crdInformerFactory.Edgeclusters().V1().EdgeClusters()
+		dc.edgeClusterLister = edgeClustersInformer.Lister()
+
+		if enabled.Has("edgecluster") {
+			edgeClusterManager, err := manager.NewEdgeClusterManager(edgeClustersInformer.Informer())
+			if err != nil {
+				klog.Warningf("Create edgeClusterManager failed with error: %s", err)
+				return nil, err
+			}
+			dc.edgeClusterManager = edgeClusterManager
+			dc.edgeClusterQueue = newMessageQueue("edgecluster")
+		}
 	}
+
 	if err := dc.initLocating(); err != nil {
 		return nil, err
 	}
@@ -620,3 +1137,52 @@ func (dc *DownstreamController) SendMessage(msg *model.Message) {
 		klog.V(4).Infof("message sent successfully, operation: %s, resource: %s", msg.GetOperation(), msg.GetResource())
 	}
 }
+
+// runMessageQueue drains q, delivering each pending message through the
+// message layer and requeuing failures with backoff up to maxMessageRetries.
+func (dc *DownstreamController) runMessageQueue(q *messageQueue) {
+	for {
+		key, shutdown := q.queue.Get()
+		if shutdown {
+			return
+		}
+		dc.processQueueKey(q, key.(string))
+	}
+}
+
+func (dc *DownstreamController) processQueueKey(q *messageQueue, key string) {
+	defer q.queue.Done(key)
+
+	q.mu.Lock()
+	msg, ok := q.pending[key]
+	delete(q.pending, key)
+	q.mu.Unlock()
+	downstreamQueueDepth.WithLabelValues(q.kind).Set(float64(q.queue.Len()))
+
+	if !ok {
+		// already delivered by a newer Add for the same key
+		q.queue.Forget(key)
+		return
+	}
+
+	if err := dc.messageLayer.Send(*msg); err != nil {
+		if q.queue.NumRequeues(key) < maxMessageRetries {
+			klog.Warningf("send %s message for %s failed, will retry: %s", q.kind, key, err)
+			downstreamRetryTotal.WithLabelValues(q.kind).Inc()
+
+			q.mu.Lock()
+			// keep a newer message added while this one was being sent
+			if _, newer := q.pending[key]; !newer {
+				q.pending[key] = msg
+			}
+			q.mu.Unlock()
+			q.queue.AddRateLimited(key)
+			return
+		}
+
+		klog.Warningf("dropping %s message for %s after %d retries: %s", q.kind, key, maxMessageRetries, err)
+		downstreamDropTotal.WithLabelValues(q.kind).Inc()
+	}
+
+	q.queue.Forget(key)
+}
@@ -0,0 +1,437 @@
+package controller
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubeedge/beehive/pkg/core/model"
+	edgeclustersv1 "github.com/kubeedge/kubeedge/cloud/pkg/apis/edgeclusters/v1"
+)
+
+func TestParseControllerFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{
+			name: "empty enables every known controller",
+			raw:  "",
+			want: knownControllers,
+		},
+		{
+			name: "bare token enables only that controller",
+			raw:  "mission",
+			want: []string{"mission"},
+		},
+		{
+			name: "plus token on an already-enabled controller is a no-op",
+			raw:  "+mission",
+			want: knownControllers,
+		},
+		{
+			name: "minus token removes from the default set",
+			raw:  "-rule",
+			want: remove(knownControllers, "rule"),
+		},
+		{
+			name: "multiple minus tokens each remove from the default set",
+			raw:  "-rule,-ruleendpoint",
+			want: remove(remove(knownControllers, "rule"), "ruleendpoint"),
+		},
+		{
+			name: "unknown tokens are dropped",
+			raw:  "+not-a-real-controller",
+			want: knownControllers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseControllerFlags(tt.raw).List()
+			sort.Strings(got)
+			want := append([]string{}, tt.want...)
+			sort.Strings(want)
+
+			if !equalStrings(got, want) {
+				t.Errorf("ParseControllerFlags(%q) = %v, want %v", tt.raw, got, want)
+			}
+		})
+	}
+}
+
+func remove(in []string, drop string) []string {
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		if s != drop {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// fakeEdgeClusterLister is a minimal stand-in for crdlister.EdgeClusterLister.
+type fakeEdgeClusterLister struct {
+	clusters []*edgeclustersv1.EdgeCluster
+}
+
+func (f *fakeEdgeClusterLister) List(selector labels.Selector) ([]*edgeclustersv1.EdgeCluster, error) {
+	var ret []*edgeclustersv1.EdgeCluster
+	for _, c := range f.clusters {
+		if selector.Matches(labels.Set(c.Labels)) {
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
+
+func (f *fakeEdgeClusterLister) Get(name string) (*edgeclustersv1.EdgeCluster, error) {
+	for _, c := range f.clusters {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestResolveMissionTargets(t *testing.T) {
+	lister := &fakeEdgeClusterLister{
+		clusters: []*edgeclustersv1.EdgeCluster{
+			{ObjectMeta: metav1.ObjectMeta{Name: "east-1", Labels: map[string]string{"region": "east"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "east-2", Labels: map[string]string{"region": "east"}}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "west-1", Labels: map[string]string{"region": "west"}}},
+		},
+	}
+	dc := &DownstreamController{edgeClusterLister: lister}
+
+	tests := []struct {
+		name     string
+		mission  *edgeclustersv1.Mission
+		expected []string
+	}{
+		{
+			name: "explicit cluster names only",
+			mission: &edgeclustersv1.Mission{
+				Spec: edgeclustersv1.MissionSpec{
+					Placement: edgeclustersv1.MissionPlacement{ClusterNames: []string{"west-1"}},
+				},
+			},
+			expected: []string{"west-1"},
+		},
+		{
+			name: "selector only",
+			mission: &edgeclustersv1.Mission{
+				Spec: edgeclustersv1.MissionSpec{
+					Placement: edgeclustersv1.MissionPlacement{
+						ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+					},
+				},
+			},
+			expected: []string{"east-1", "east-2"},
+		},
+		{
+			name: "selector and explicit names union",
+			mission: &edgeclustersv1.Mission{
+				Spec: edgeclustersv1.MissionSpec{
+					Placement: edgeclustersv1.MissionPlacement{
+						ClusterNames:    []string{"west-1"},
+						ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+					},
+				},
+			},
+			expected: []string{"east-1", "east-2", "west-1"},
+		},
+		{
+			name:     "no placement matches nothing",
+			mission:  &edgeclustersv1.Mission{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dc.resolveMissionTargets(tt.mission)
+			if err != nil {
+				t.Fatalf("resolveMissionTargets returned error: %v", err)
+			}
+
+			gotList := got.List()
+			sort.Strings(gotList)
+			want := append([]string{}, tt.expected...)
+			sort.Strings(want)
+
+			if !equalStrings(gotList, want) {
+				t.Errorf("resolveMissionTargets() = %v, want %v", gotList, want)
+			}
+		})
+	}
+}
+
+// TestMissionTargetDelta exercises the stale-cluster computation syncMissions
+// performs on a placement change: clusters that matched the old placement but
+// not the new one must show up as a delete target.
+func TestMissionTargetDelta(t *testing.T) {
+	dc := &DownstreamController{edgeClusterLister: &fakeEdgeClusterLister{}}
+
+	before := &edgeclustersv1.Mission{
+		Spec: edgeclustersv1.MissionSpec{
+			Placement: edgeclustersv1.MissionPlacement{ClusterNames: []string{"east-1", "west-1"}},
+		},
+	}
+	after := &edgeclustersv1.Mission{
+		Spec: edgeclustersv1.MissionSpec{
+			Placement: edgeclustersv1.MissionPlacement{ClusterNames: []string{"west-1"}},
+		},
+	}
+
+	previous, err := dc.resolveMissionTargets(before)
+	if err != nil {
+		t.Fatalf("resolveMissionTargets(before) returned error: %v", err)
+	}
+	current, err := dc.resolveMissionTargets(after)
+	if err != nil {
+		t.Fatalf("resolveMissionTargets(after) returned error: %v", err)
+	}
+
+	stale := previous.Difference(current)
+	if !equalStrings(stale.List(), []string{"east-1"}) {
+		t.Errorf("stale clusters = %v, want [east-1]", stale.List())
+	}
+
+	unchanged := current.Difference(current)
+	if unchanged.Len() != 0 {
+		t.Errorf("expected no stale clusters against an unchanged target set, got %v", unchanged.List())
+	}
+}
+
+// TestMissionDelta exercises the per-item add/update/remove classification
+// syncEdgeClusters uses instead of shipping the full mission list on every
+// event.
+func TestMissionDelta(t *testing.T) {
+	missionByName := map[string]*edgeclustersv1.Mission{
+		"m1": {ObjectMeta: metav1.ObjectMeta{Name: "m1", ResourceVersion: "2"}},
+		"m2": {ObjectMeta: metav1.ObjectMeta{Name: "m2", ResourceVersion: "1"}},
+		"m3": {ObjectMeta: metav1.ObjectMeta{Name: "m3", ResourceVersion: "1"}},
+	}
+	// cloud has m1 (updated to rv 2) and m2; edge last reported m2 and m3.
+	missionsInCloud := sets.NewString("m1", "m2")
+	missionsInEdge := sets.NewString("m2", "m3")
+	observedVersions := map[string]string{"m2": "1"}
+
+	toAdd, toUpdate, toRemove := missionDelta(missionsInCloud, missionsInEdge, missionByName, func(name string) string {
+		return observedVersions[name]
+	})
+
+	if !equalStrings(toAdd.List(), []string{"m1"}) {
+		t.Errorf("toAdd = %v, want [m1]", toAdd.List())
+	}
+	if !equalStrings(toUpdate.List(), nil) {
+		t.Errorf("toUpdate = %v, want none (m2's observed version matches)", toUpdate.List())
+	}
+	if !equalStrings(toRemove.List(), []string{"m3"}) {
+		t.Errorf("toRemove = %v, want [m3]", toRemove.List())
+	}
+
+	// bump m2's resource version on the cloud side without the edge having
+	// observed it yet: it should now show up as an update, not a no-op.
+	missionByName["m2"].ResourceVersion = "2"
+	toAdd, toUpdate, toRemove = missionDelta(missionsInCloud, missionsInEdge, missionByName, func(name string) string {
+		return observedVersions[name]
+	})
+	if !equalStrings(toUpdate.List(), []string{"m2"}) {
+		t.Errorf("toUpdate = %v, want [m2] after resource version bump", toUpdate.List())
+	}
+}
+
+// fakeMessageLayer is a minimal stand-in for messagelayer.MessageLayer: Send
+// fails the first `fail` times it's called, then succeeds.
+type fakeMessageLayer struct {
+	mu   sync.Mutex
+	fail int
+	sent []model.Message
+}
+
+func (f *fakeMessageLayer) Send(msg model.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail > 0 {
+		f.fail--
+		return errors.New("send failed")
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func TestMessageQueueCoalescesPendingUpdates(t *testing.T) {
+	q := newMessageQueue("test")
+	defer q.ShutDown()
+
+	first := model.NewMessage("")
+	second := model.NewMessage("")
+	q.Add("key", first)
+	q.Add("key", second)
+
+	if q.queue.Len() != 1 {
+		t.Fatalf("queue length = %d, want 1 (duplicate Add before processing should coalesce)", q.queue.Len())
+	}
+	if q.pending["key"] != second {
+		t.Errorf("pending[key] = %v, want the latest message for the key", q.pending["key"])
+	}
+}
+
+// TestProcessQueueKeyDropsAfterMaxRetries exercises the give-up path: once a
+// key has been requeued maxMessageRetries times, the next failure drops it
+// instead of requeuing it again.
+func TestProcessQueueKeyDropsAfterMaxRetries(t *testing.T) {
+	layer := &fakeMessageLayer{fail: maxMessageRetries + 1}
+	dc := &DownstreamController{messageLayer: layer}
+	q := newMessageQueue("test")
+	defer q.ShutDown()
+
+	q.Add("key", model.NewMessage(""))
+	for i := 0; i <= maxMessageRetries; i++ {
+		dc.processQueueKey(q, "key")
+	}
+
+	if _, pending := q.pending["key"]; pending {
+		t.Errorf("message for key still pending after exceeding maxMessageRetries")
+	}
+	if len(layer.sent) != 0 {
+		t.Errorf("message delivered despite every send failing, sent: %v", layer.sent)
+	}
+}
+
+// TestAppendEndpointSlice exercises the dedup-by-name fold-in that
+// syncEndpointSlices relies on to make sure the triggering slice is reflected
+// in the aggregated snapshot even if the lister's cache hasn't caught up yet.
+func TestAppendEndpointSlice(t *testing.T) {
+	existing := &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Name: "svc-abcde", ResourceVersion: "1"}}
+	other := &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Name: "svc-fghij", ResourceVersion: "1"}}
+
+	t.Run("new slice is appended", func(t *testing.T) {
+		got := appendEndpointSlice([]*discoveryv1.EndpointSlice{other}, existing)
+		if len(got) != 2 {
+			t.Fatalf("len(got) = %d, want 2", len(got))
+		}
+	})
+
+	t.Run("slice with the same name replaces the existing entry", func(t *testing.T) {
+		updated := &discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Name: "svc-abcde", ResourceVersion: "2"}}
+		got := appendEndpointSlice([]*discoveryv1.EndpointSlice{existing, other}, updated)
+		if len(got) != 2 {
+			t.Fatalf("len(got) = %d, want 2", len(got))
+		}
+		for _, s := range got {
+			if s.Name == "svc-abcde" && s.ResourceVersion != "2" {
+				t.Errorf("slice %s not replaced, resource version = %s, want 2", s.Name, s.ResourceVersion)
+			}
+		}
+	})
+}
+
+// TestSyncEndpointSlicesOperationFromRemainingSlices documents the operation
+// rule syncEndpointSlices applies once it has the post-removal aggregated
+// slice list: the message always carries every slice still backing the
+// service, so only the service having no slices left at all is a Delete —
+// removing one of several slices is an Update, not a Delete, since the
+// remaining slices' endpoints are still valid on the edge.
+func TestSyncEndpointSlicesOperationFromRemainingSlices(t *testing.T) {
+	tests := []struct {
+		name          string
+		slices        []*discoveryv1.EndpointSlice
+		wantOperation string
+	}{
+		{
+			name:          "no slices left for the service is a delete",
+			slices:        nil,
+			wantOperation: model.DeleteOperation,
+		},
+		{
+			name:          "other slices still back the service is an update",
+			slices:        []*discoveryv1.EndpointSlice{{ObjectMeta: metav1.ObjectMeta{Name: "svc-fghij"}}},
+			wantOperation: model.UpdateOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := endpointSliceOperation(tt.slices)
+			if operation != tt.wantOperation {
+				t.Errorf("operation = %q, want %q", operation, tt.wantOperation)
+			}
+		})
+	}
+}
+
+func TestResyncOperation(t *testing.T) {
+	tests := []struct {
+		name            string
+		eventType       watch.EventType
+		observedVersion string
+		currentVersion  string
+		wantOperation   string
+		wantSkip        bool
+	}{
+		{
+			name:          "added always syncs",
+			eventType:     watch.Added,
+			wantOperation: model.InsertOperation,
+		},
+		{
+			name:          "deleted always syncs",
+			eventType:     watch.Deleted,
+			wantOperation: model.DeleteOperation,
+		},
+		{
+			name:            "modified with an unchanged resource version is a no-op resync",
+			eventType:       watch.Modified,
+			observedVersion: "5",
+			currentVersion:  "5",
+			wantSkip:        true,
+		},
+		{
+			name:            "modified with a new resource version syncs",
+			eventType:       watch.Modified,
+			observedVersion: "5",
+			currentVersion:  "6",
+			wantOperation:   model.UpdateOperation,
+		},
+		{
+			name:      "unsupported event type is skipped",
+			eventType: watch.Bookmark,
+			wantSkip:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation, skip := resyncOperation(tt.eventType, tt.observedVersion, tt.currentVersion)
+			if skip != tt.wantSkip {
+				t.Errorf("skip = %v, want %v", skip, tt.wantSkip)
+			}
+			if !skip && operation != tt.wantOperation {
+				t.Errorf("operation = %q, want %q", operation, tt.wantOperation)
+			}
+		})
+	}
+}